@@ -0,0 +1,74 @@
+package pingdom
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewRequestUsesBearerTokenWhenSet(t *testing.T) {
+	c, err := NewClientWithConfig(ClientConfig{Token: "tok123", APIVersion: APIVersion31})
+	if err != nil {
+		t.Fatalf("NewClientWithConfig: %v", err)
+	}
+
+	req, err := c.NewRequest("GET", "/checks", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	if got, want := req.Header.Get("Authorization"), "Bearer tok123"; got != want {
+		t.Errorf("Authorization header = %q, want %q", got, want)
+	}
+	if _, _, ok := req.BasicAuth(); ok {
+		t.Error("request should not carry basic auth when a Token is set")
+	}
+	if req.Header.Get("App-Key") != "" {
+		t.Error("request should not carry an App-Key header when a Token is set")
+	}
+}
+
+func TestNewRequestFallsBackToBasicAuthWithoutToken(t *testing.T) {
+	c, err := NewClientWithConfig(ClientConfig{User: "u", Password: "p", APIKey: "key"})
+	if err != nil {
+		t.Fatalf("NewClientWithConfig: %v", err)
+	}
+
+	req, err := c.NewRequest("GET", "/checks", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	user, pass, ok := req.BasicAuth()
+	if !ok || user != "u" || pass != "p" {
+		t.Errorf("BasicAuth() = (%q, %q, %v), want (\"u\", \"p\", true)", user, pass, ok)
+	}
+	if got := req.Header.Get("App-Key"); got != "key" {
+		t.Errorf("App-Key header = %q, want %q", got, "key")
+	}
+	if got := req.Header.Get("Authorization"); !strings.HasPrefix(got, "Basic ") {
+		t.Errorf("Authorization header = %q, want a Basic scheme (not Bearer)", got)
+	}
+}
+
+func TestNewClientWithConfigDefaultsAPIVersionAndBaseURL(t *testing.T) {
+	c, err := NewClientWithConfig(ClientConfig{APIKey: "key"})
+	if err != nil {
+		t.Fatalf("NewClientWithConfig: %v", err)
+	}
+	if c.APIVersion != APIVersion21 {
+		t.Errorf("APIVersion = %q, want %q", c.APIVersion, APIVersion21)
+	}
+	if c.BaseURL.String() != defaultBaseURL {
+		t.Errorf("BaseURL = %q, want %q", c.BaseURL.String(), defaultBaseURL)
+	}
+}
+
+func TestNewClientWithConfigUsesV3BaseURLWhenRequested(t *testing.T) {
+	c, err := NewClientWithConfig(ClientConfig{Token: "tok", APIVersion: APIVersion31})
+	if err != nil {
+		t.Fatalf("NewClientWithConfig: %v", err)
+	}
+	if c.BaseURL.String() != defaultBaseURLV3 {
+		t.Errorf("BaseURL = %q, want %q", c.BaseURL.String(), defaultBaseURLV3)
+	}
+}
@@ -0,0 +1,177 @@
+package pingdom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Check represents the payload used to create or update a Pingdom check.
+type Check struct {
+	Name       string
+	Host       string
+	Type       string
+	Resolution int
+	Paused     bool
+	Tags       []string
+}
+
+// params converts c into the form-encoded params the checks endpoint
+// expects. The write side is unchanged from 2.1: tags are still sent as
+// a single comma-separated string. The only confirmed 3.1 change is on
+// the read side, where tags come back as structured objects instead of
+// plain strings — see CheckResponse.Tags and Tag.
+func (c *Check) params() map[string]string {
+	p := map[string]string{
+		"name":       c.Name,
+		"host":       c.Host,
+		"type":       c.Type,
+		"resolution": strconv.Itoa(c.Resolution),
+		"paused":     strconv.FormatBool(c.Paused),
+	}
+	if len(c.Tags) > 0 {
+		p["tags"] = strings.Join(c.Tags, ",")
+	}
+	return p
+}
+
+// Tag is a tag as returned on a check. API 2.1 lists tags as plain
+// strings; 3.1 replaced that with this structured object, so Type and
+// Count are always empty/zero for a 2.1 client.
+type Tag struct {
+	Name  string `json:"name"`
+	Type  string `json:"type,omitempty"`
+	Count int    `json:"count,omitempty"`
+}
+
+// UnmarshalJSON accepts either the 3.1 object form or a 2.1 plain
+// string, which becomes Name.
+func (t *Tag) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		*t = Tag{Name: name}
+		return nil
+	}
+	type tag Tag
+	return json.Unmarshal(b, (*tag)(t))
+}
+
+// CheckResponse represents a Pingdom check as returned by the API.
+type CheckResponse struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Hostname string `json:"hostname"`
+	Status   string `json:"status"`
+	Tags     []Tag  `json:"tags,omitempty"`
+}
+
+type checkListResponse struct {
+	Checks []CheckResponse `json:"checks"`
+}
+
+type checkDetailsResponse struct {
+	Check CheckResponse `json:"check"`
+}
+
+// CheckService provides access to the /checks resource.
+type CheckService struct {
+	client *Client
+}
+
+// List returns all checks, optionally filtered by the given params (e.g.
+// {"tags": "foo,bar"}).
+func (cs *CheckService) List(params ...map[string]string) ([]CheckResponse, error) {
+	return cs.ListContext(context.Background(), params...)
+}
+
+// ListContext is the context-aware equivalent of List.
+func (cs *CheckService) ListContext(ctx context.Context, params ...map[string]string) ([]CheckResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/checks", mergeParams(params))
+	if err != nil {
+		return nil, err
+	}
+
+	m := &checkListResponse{}
+	if _, err := cs.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m.Checks, nil
+}
+
+// Read returns a single check by id.
+func (cs *CheckService) Read(id int) (*CheckResponse, error) {
+	return cs.ReadContext(context.Background(), id)
+}
+
+// ReadContext is the context-aware equivalent of Read.
+func (cs *CheckService) ReadContext(ctx context.Context, id int) (*CheckResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/checks/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &checkDetailsResponse{}
+	if _, err := cs.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return &m.Check, nil
+}
+
+// Create creates a new check.
+func (cs *CheckService) Create(check *Check) (*CheckResponse, error) {
+	return cs.CreateContext(context.Background(), check)
+}
+
+// CreateContext is the context-aware equivalent of Create.
+func (cs *CheckService) CreateContext(ctx context.Context, check *Check) (*CheckResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "POST", "/checks", check.params())
+	if err != nil {
+		return nil, err
+	}
+
+	m := &checkDetailsResponse{}
+	if _, err := cs.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return &m.Check, nil
+}
+
+// Update updates an existing check.
+func (cs *CheckService) Update(id int, check *Check) (*PingdomResponse, error) {
+	return cs.UpdateContext(context.Background(), id, check)
+}
+
+// UpdateContext is the context-aware equivalent of Update.
+func (cs *CheckService) UpdateContext(ctx context.Context, id int, check *Check) (*PingdomResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("/checks/%d", id), check.params())
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	if _, err := cs.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Delete removes a check.
+func (cs *CheckService) Delete(id int) (*PingdomResponse, error) {
+	return cs.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is the context-aware equivalent of Delete.
+func (cs *CheckService) DeleteContext(ctx context.Context, id int) (*PingdomResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/checks/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	if _, err := cs.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
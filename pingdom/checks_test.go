@@ -0,0 +1,105 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	c, err := NewClientWithConfig(ClientConfig{APIKey: "key", BaseURL: srv.URL})
+	if err != nil {
+		t.Fatalf("NewClientWithConfig: %v", err)
+	}
+	return c, srv
+}
+
+func TestCheckServiceListSendsFilterParamsAndParsesEnvelope(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, "GET"; got != want {
+			t.Errorf("method = %q, want %q", got, want)
+		}
+		if got, want := r.URL.Path, "/checks"; got != want {
+			t.Errorf("path = %q, want %q", got, want)
+		}
+		if got, want := r.URL.Query().Get("tags"), "foo,bar"; got != want {
+			t.Errorf("tags param = %q, want %q", got, want)
+		}
+		json.NewEncoder(w).Encode(checkListResponse{
+			Checks: []CheckResponse{{ID: 1, Name: "site", Hostname: "example.com", Status: "up"}},
+		})
+	})
+	defer srv.Close()
+
+	checks, err := c.Checks.List(map[string]string{"tags": "foo,bar"})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(checks) != 1 || checks[0].ID != 1 || checks[0].Hostname != "example.com" {
+		t.Errorf("List() = %+v, want a single check with id 1", checks)
+	}
+}
+
+func TestCheckServiceCreateSendsParamsAndReturnsCheck(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, "POST"; got != want {
+			t.Errorf("method = %q, want %q", got, want)
+		}
+		q := r.URL.Query()
+		if got, want := q.Get("name"), "site"; got != want {
+			t.Errorf("name param = %q, want %q", got, want)
+		}
+		if got, want := q.Get("host"), "example.com"; got != want {
+			t.Errorf("host param = %q, want %q", got, want)
+		}
+		if got, want := q.Get("tags"), "a,b"; got != want {
+			t.Errorf("tags param = %q, want %q", got, want)
+		}
+		json.NewEncoder(w).Encode(checkDetailsResponse{
+			Check: CheckResponse{ID: 42, Name: "site", Tags: []Tag{{Name: "a"}}},
+		})
+	})
+	defer srv.Close()
+
+	check, err := c.Checks.Create(&Check{Name: "site", Host: "example.com", Tags: []string{"a", "b"}})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if check.ID != 42 || len(check.Tags) != 1 || check.Tags[0].Name != "a" {
+		t.Errorf("Create() = %+v, want id 42 with a structured tag", check)
+	}
+}
+
+func TestCheckServiceReadAndDeleteBuildExpectedPaths(t *testing.T) {
+	var gotMethods []string
+	var gotPaths []string
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotMethods = append(gotMethods, r.Method)
+		gotPaths = append(gotPaths, r.URL.Path)
+		switch r.Method {
+		case "GET":
+			json.NewEncoder(w).Encode(checkDetailsResponse{Check: CheckResponse{ID: 7}})
+		case "DELETE":
+			json.NewEncoder(w).Encode(PingdomResponse{Message: "ok"})
+		}
+	})
+	defer srv.Close()
+
+	if _, err := c.Checks.Read(7); err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if _, err := c.Checks.Delete(7); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	wantMethods := []string{"GET", "DELETE"}
+	wantPaths := []string{"/checks/7", "/checks/7"}
+	for i := range wantMethods {
+		if gotMethods[i] != wantMethods[i] || gotPaths[i] != wantPaths[i] {
+			t.Errorf("call %d = %s %s, want %s %s", i, gotMethods[i], gotPaths[i], wantMethods[i], wantPaths[i])
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// errorJSONResponse is the envelope Pingdom wraps non-2xx error bodies in.
+type errorJSONResponse struct {
+	Error errorJSON `json:"error"`
+}
+
+// errorJSON is the error object inside a Pingdom error envelope. Fields
+// is only populated for validation failures, where Pingdom reports
+// per-field details.
+type errorJSON struct {
+	StatusCode int                 `json:"statuscode"`
+	StatusDesc string              `json:"statusdesc"`
+	Message    string              `json:"errormessage"`
+	Fields     map[string][]string `json:"errors,omitempty"`
+}
+
+func (e errorJSON) Error() string {
+	return fmt.Sprintf("%d %v: %v", e.StatusCode, e.StatusDesc, e.Message)
+}
+
+// APIError is returned for any non-2xx Pingdom response that doesn't
+// match one of the more specific error types below. It embeds the raw
+// *http.Response so callers can inspect status, headers, or the request
+// that produced it.
+type APIError struct {
+	Response *http.Response
+	Detail   errorJSON
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("pingdom: %s %s: %s", e.Response.Request.Method, e.Response.Request.URL, e.Detail.Error())
+}
+
+// AuthError is returned for 401/403 responses.
+type AuthError struct {
+	*APIError
+}
+
+// NotFoundError is returned for 404 responses.
+type NotFoundError struct {
+	*APIError
+}
+
+// ValidationError is returned for 422 responses. Per-field validation
+// details, when Pingdom's error envelope includes them, are available via
+// Detail.Fields.
+type ValidationError struct {
+	*APIError
+}
+
+// RateLimitError is returned for 429 responses. RetryAfter holds the
+// parsed Retry-After duration, or zero if the header was absent or
+// unparseable.
+type RateLimitError struct {
+	*APIError
+	RetryAfter time.Duration
+}
+
+// validateResponse takes an HTTP response and determines whether it was
+// successful. It returns nil if the HTTP status code is within the 2xx
+// range, and a typed error otherwise: *RateLimitError (429), *AuthError
+// (401/403), *NotFoundError (404), *ValidationError (422), or a generic
+// *APIError for anything else. Callers can errors.As on the specific
+// failure mode they care about instead of string-matching.
+func validateResponse(r *http.Response) error {
+	if c := r.StatusCode; 200 <= c && c <= 299 {
+		return nil
+	}
+
+	// A body that isn't Pingdom's error envelope still yields a typed
+	// error; Detail then carries only the HTTP status.
+	m := &errorJSONResponse{}
+	if err := json.NewDecoder(r.Body).Decode(m); err != nil {
+		m.Error = errorJSON{StatusCode: r.StatusCode, StatusDesc: http.StatusText(r.StatusCode)}
+	}
+
+	base := &APIError{Response: r, Detail: m.Error}
+
+	switch r.StatusCode {
+	case http.StatusTooManyRequests:
+		return &RateLimitError{APIError: base, RetryAfter: retryAfter(r)}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return &AuthError{APIError: base}
+	case http.StatusNotFound:
+		return &NotFoundError{APIError: base}
+	case http.StatusUnprocessableEntity:
+		return &ValidationError{APIError: base}
+	default:
+		return base
+	}
+}
+
+// retryAfter parses r's Retry-After header, returning zero if it is
+// absent or not a plain integer number of seconds.
+func retryAfter(r *http.Response) time.Duration {
+	ra := r.Header.Get("Retry-After")
+	if ra == "" {
+		return 0
+	}
+	secs, err := strconv.Atoi(ra)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
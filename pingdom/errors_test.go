@@ -0,0 +1,115 @@
+package pingdom
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func newErrorResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    &http.Request{Method: "GET", URL: &url.URL{Path: "/checks"}},
+	}
+}
+
+func TestValidateResponseTypedErrors(t *testing.T) {
+	cases := []struct {
+		status int
+		body   string
+		check  func(t *testing.T, err error)
+	}{
+		{http.StatusTooManyRequests, `{"error":{"statuscode":429,"statusdesc":"Too Many Requests","errormessage":"slow down"}}`, func(t *testing.T, err error) {
+			var rle *RateLimitError
+			if !errors.As(err, &rle) {
+				t.Fatalf("errors.As(%v, *RateLimitError) = false", err)
+			}
+		}},
+		{http.StatusUnauthorized, `{"error":{"statuscode":401,"statusdesc":"Unauthorized","errormessage":"bad creds"}}`, func(t *testing.T, err error) {
+			var ae *AuthError
+			if !errors.As(err, &ae) {
+				t.Fatalf("errors.As(%v, *AuthError) = false", err)
+			}
+		}},
+		{http.StatusNotFound, `{"error":{"statuscode":404,"statusdesc":"Not Found","errormessage":"no such check"}}`, func(t *testing.T, err error) {
+			var nfe *NotFoundError
+			if !errors.As(err, &nfe) {
+				t.Fatalf("errors.As(%v, *NotFoundError) = false", err)
+			}
+		}},
+		{http.StatusUnprocessableEntity, `{"error":{"statuscode":422,"statusdesc":"Unprocessable Entity","errormessage":"bad field","errors":{"name":["required"]}}}`, func(t *testing.T, err error) {
+			var ve *ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("errors.As(%v, *ValidationError) = false", err)
+			}
+			if got := ve.Detail.Fields["name"]; len(got) != 1 || got[0] != "required" {
+				t.Errorf("Detail.Fields[name] = %v, want [required]", got)
+			}
+		}},
+		{http.StatusInternalServerError, `{"error":{"statuscode":500,"statusdesc":"Internal Server Error","errormessage":"boom"}}`, func(t *testing.T, err error) {
+			var ae *APIError
+			if !errors.As(err, &ae) {
+				t.Fatalf("errors.As(%v, *APIError) = false", err)
+			}
+			var rle *RateLimitError
+			if errors.As(err, &rle) {
+				t.Error("a 500 should not be a RateLimitError")
+			}
+		}},
+	}
+
+	for _, c := range cases {
+		resp := newErrorResponse(c.status, c.body)
+		err := validateResponse(resp)
+		if err == nil {
+			t.Fatalf("status %d: validateResponse returned nil error", c.status)
+		}
+		c.check(t, err)
+	}
+}
+
+func TestValidateResponseOKReturnsNil(t *testing.T) {
+	resp := newErrorResponse(200, "")
+	if err := validateResponse(resp); err != nil {
+		t.Errorf("validateResponse(200) = %v, want nil", err)
+	}
+}
+
+func TestRateLimitErrorParsesRetryAfter(t *testing.T) {
+	resp := newErrorResponse(http.StatusTooManyRequests, `{"error":{"statuscode":429,"statusdesc":"Too Many Requests","errormessage":"slow down"}}`)
+	resp.Header = http.Header{"Retry-After": []string{"30"}}
+
+	err := validateResponse(resp)
+	var rle *RateLimitError
+	if !errors.As(err, &rle) {
+		t.Fatalf("expected *RateLimitError, got %T", err)
+	}
+	if rle.RetryAfter.Seconds() != 30 {
+		t.Errorf("RetryAfter = %v, want 30s", rle.RetryAfter)
+	}
+}
+
+func TestDecodeResponseStreamsBody(t *testing.T) {
+	resp := &http.Response{Body: io.NopCloser(strings.NewReader(`{"id":42}`))}
+
+	var out struct {
+		ID int `json:"id"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		t.Fatalf("decodeResponse: %v", err)
+	}
+	if out.ID != 42 {
+		t.Errorf("ID = %d, want 42", out.ID)
+	}
+}
+
+func TestDecodeResponseNilInterface(t *testing.T) {
+	resp := &http.Response{Body: io.NopCloser(strings.NewReader(`{}`))}
+	if err := decodeResponse(resp, nil); err == nil {
+		t.Fatal("expected an error for a nil interface")
+	}
+}
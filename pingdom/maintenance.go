@@ -0,0 +1,131 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Maintenance represents the payload used to create or update a
+// maintenance window.
+type Maintenance struct {
+	Description string
+	From        int64
+	To          int64
+	CheckIDs    []int
+}
+
+// params converts m into the form-encoded params the maintenance
+// endpoint expects. No 3.1 field renames were found for this resource;
+// the payload shape is identical to 2.1.
+func (m *Maintenance) params() map[string]string {
+	ids := make([]string, len(m.CheckIDs))
+	for i, id := range m.CheckIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+	return map[string]string{
+		"description": m.Description,
+		"from":        strconv.FormatInt(m.From, 10),
+		"to":          strconv.FormatInt(m.To, 10),
+		"checkids":    strings.Join(ids, ","),
+	}
+}
+
+// MaintenanceResponse represents a maintenance window as returned by the
+// API.
+type MaintenanceResponse struct {
+	ID          int    `json:"id"`
+	Description string `json:"description"`
+	From        int64  `json:"from"`
+	To          int64  `json:"to"`
+}
+
+type maintenanceListResponse struct {
+	Maintenances []MaintenanceResponse `json:"maintenance"`
+}
+
+type maintenanceDetailsResponse struct {
+	Maintenance MaintenanceResponse `json:"maintenance"`
+}
+
+// MaintenanceService provides access to the /maintenance resource.
+type MaintenanceService struct {
+	client *Client
+}
+
+// List returns all maintenance windows.
+func (ms *MaintenanceService) List(params ...map[string]string) ([]MaintenanceResponse, error) {
+	return ms.ListContext(context.Background(), params...)
+}
+
+// ListContext is the context-aware equivalent of List.
+func (ms *MaintenanceService) ListContext(ctx context.Context, params ...map[string]string) ([]MaintenanceResponse, error) {
+	req, err := ms.client.NewRequestWithContext(ctx, "GET", "/maintenance", mergeParams(params))
+	if err != nil {
+		return nil, err
+	}
+
+	m := &maintenanceListResponse{}
+	if _, err := ms.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m.Maintenances, nil
+}
+
+// Read returns a single maintenance window by id.
+func (ms *MaintenanceService) Read(id int) (*MaintenanceResponse, error) {
+	return ms.ReadContext(context.Background(), id)
+}
+
+// ReadContext is the context-aware equivalent of Read.
+func (ms *MaintenanceService) ReadContext(ctx context.Context, id int) (*MaintenanceResponse, error) {
+	req, err := ms.client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/maintenance/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &maintenanceDetailsResponse{}
+	if _, err := ms.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return &m.Maintenance, nil
+}
+
+// Create creates a new maintenance window.
+func (ms *MaintenanceService) Create(maintenance *Maintenance) (*MaintenanceResponse, error) {
+	return ms.CreateContext(context.Background(), maintenance)
+}
+
+// CreateContext is the context-aware equivalent of Create.
+func (ms *MaintenanceService) CreateContext(ctx context.Context, maintenance *Maintenance) (*MaintenanceResponse, error) {
+	req, err := ms.client.NewRequestWithContext(ctx, "POST", "/maintenance", maintenance.params())
+	if err != nil {
+		return nil, err
+	}
+
+	m := &maintenanceDetailsResponse{}
+	if _, err := ms.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return &m.Maintenance, nil
+}
+
+// Delete removes a maintenance window.
+func (ms *MaintenanceService) Delete(id int) (*PingdomResponse, error) {
+	return ms.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is the context-aware equivalent of Delete.
+func (ms *MaintenanceService) DeleteContext(ctx context.Context, id int) (*PingdomResponse, error) {
+	req, err := ms.client.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/maintenance/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	if _, err := ms.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,47 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestMaintenanceServiceCreateSendsCheckIDsAndReturnsWindow(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/maintenance"; got != want {
+			t.Errorf("path = %q, want %q", got, want)
+		}
+		if got, want := r.URL.Query().Get("checkids"), "1,2,3"; got != want {
+			t.Errorf("checkids param = %q, want %q", got, want)
+		}
+		json.NewEncoder(w).Encode(maintenanceDetailsResponse{
+			Maintenance: MaintenanceResponse{ID: 9, Description: "maint"},
+		})
+	})
+	defer srv.Close()
+
+	m, err := c.Maintenances.Create(&Maintenance{Description: "maint", CheckIDs: []int{1, 2, 3}})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if m.ID != 9 || m.Description != "maint" {
+		t.Errorf("Create() = %+v, want id 9", m)
+	}
+}
+
+func TestMaintenanceServiceListParsesEnvelope(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(maintenanceListResponse{
+			Maintenances: []MaintenanceResponse{{ID: 1}, {ID: 2}},
+		})
+	})
+	defer srv.Close()
+
+	ms, err := c.Maintenances.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(ms) != 2 {
+		t.Errorf("List() returned %d windows, want 2", len(ms))
+	}
+}
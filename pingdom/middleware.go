@@ -0,0 +1,78 @@
+package pingdom
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+)
+
+// NewSlogRequestInterceptor returns a RequestInterceptor that logs each
+// outgoing request at debug level using logger.
+func NewSlogRequestInterceptor(logger *slog.Logger) RequestInterceptor {
+	return func(req *http.Request) error {
+		logger.Debug("pingdom request", "method", req.Method, "url", req.URL.String())
+		return nil
+	}
+}
+
+// NewSlogResponseInterceptor returns a ResponseInterceptor that logs the
+// outcome of each request using logger, at warn level on transport error
+// and debug level otherwise.
+func NewSlogResponseInterceptor(logger *slog.Logger) ResponseInterceptor {
+	return func(resp *http.Response, err error) {
+		if err != nil {
+			logger.Warn("pingdom request failed", "error", err)
+			return
+		}
+		logger.Debug("pingdom response", "status", resp.StatusCode, "url", resp.Request.URL.String())
+	}
+}
+
+// MetricsRecorder accumulates request counts keyed by resource path and
+// status code, in the style of a prometheus CounterVec, with no
+// dependency on the prometheus client library. Use Snapshot to read the
+// current counts, e.g. to export them on a metrics endpoint.
+type MetricsRecorder struct {
+	mu     sync.Mutex
+	counts map[metricsKey]int
+}
+
+type metricsKey struct {
+	path   string
+	status int
+}
+
+// NewMetricsRecorder returns an empty MetricsRecorder.
+func NewMetricsRecorder() *MetricsRecorder {
+	return &MetricsRecorder{counts: make(map[metricsKey]int)}
+}
+
+// ResponseInterceptor returns a ResponseInterceptor that records one
+// observation per completed attempt. Transport failures (err != nil, resp
+// == nil) are recorded under status 0.
+func (m *MetricsRecorder) ResponseInterceptor() ResponseInterceptor {
+	return func(resp *http.Response, err error) {
+		key := metricsKey{}
+		if resp != nil {
+			key.path = resp.Request.URL.Path
+			key.status = resp.StatusCode
+		}
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.counts[key]++
+	}
+}
+
+// Snapshot returns a copy of the current counts, keyed as "path:status".
+func (m *MetricsRecorder) Snapshot() map[string]int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make(map[string]int, len(m.counts))
+	for k, v := range m.counts {
+		out[fmt.Sprintf("%s:%d", k.path, k.status)] = v
+	}
+	return out
+}
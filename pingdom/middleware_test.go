@@ -0,0 +1,103 @@
+package pingdom
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type stubHTTPClient struct {
+	resp *http.Response
+	err  error
+}
+
+func (s *stubHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return s.resp, s.err
+}
+
+func newStubResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{},
+	}
+}
+
+func TestRequestInterceptorErrorAbortsCall(t *testing.T) {
+	called := false
+	c, err := NewClientWithConfig(ClientConfig{
+		APIKey:     "key",
+		HTTPClient: &stubHTTPClient{resp: newStubResponse(200, "{}")},
+		RequestInterceptors: []RequestInterceptor{
+			func(req *http.Request) error {
+				called = true
+				return errors.New("blocked")
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClientWithConfig: %v", err)
+	}
+
+	req, err := c.NewRequest("GET", "/checks", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	if _, err := c.Do(req, &struct{}{}); err == nil {
+		t.Fatal("expected the request interceptor's error to abort the call")
+	}
+	if !called {
+		t.Fatal("request interceptor was never invoked")
+	}
+}
+
+func TestResponseInterceptorSeesResponseStatus(t *testing.T) {
+	var gotStatus int
+	c, err := NewClientWithConfig(ClientConfig{
+		APIKey:     "key",
+		HTTPClient: &stubHTTPClient{resp: newStubResponse(200, "{}")},
+		ResponseInterceptors: []ResponseInterceptor{
+			func(resp *http.Response, err error) {
+				if resp != nil {
+					gotStatus = resp.StatusCode
+				}
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClientWithConfig: %v", err)
+	}
+
+	req, err := c.NewRequest("GET", "/checks", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	if _, err := c.Do(req, &struct{}{}); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if gotStatus != 200 {
+		t.Errorf("response interceptor saw status %d, want 200", gotStatus)
+	}
+}
+
+func TestMetricsRecorderSnapshot(t *testing.T) {
+	m := NewMetricsRecorder()
+	interceptor := m.ResponseInterceptor()
+
+	req, _ := http.NewRequest("GET", "https://example.com/checks", nil)
+	interceptor(&http.Response{StatusCode: 200, Request: req}, nil)
+	interceptor(&http.Response{StatusCode: 200, Request: req}, nil)
+	interceptor(&http.Response{StatusCode: 500, Request: req}, nil)
+
+	snap := m.Snapshot()
+	if snap["/checks:200"] != 2 {
+		t.Errorf("snapshot[/checks:200] = %d, want 2", snap["/checks:200"])
+	}
+	if snap["/checks:500"] != 1 {
+		t.Errorf("snapshot[/checks:500] = %d, want 1", snap["/checks:500"])
+	}
+}
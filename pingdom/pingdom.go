@@ -1,15 +1,31 @@
 package pingdom
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
+	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 const (
-	defaultBaseURL = "https://api.pingdom.com/api/2.1"
+	defaultBaseURL   = "https://api.pingdom.com/api/2.1"
+	defaultBaseURLV3 = "https://api.pingdom.com/api/3.1"
+
+	// APIVersion21 and APIVersion31 are the Pingdom API versions
+	// ClientConfig.APIVersion accepts. APIVersion21 remains the default
+	// for backward compatibility with existing basic-auth consumers; 2.x
+	// has been sunset, so new integrations should set APIVersion31 along
+	// with a Token.
+	APIVersion21 = "2.1"
+	APIVersion31 = "3.1"
 )
 
 // Client represents a client to the Pingdom API.  This package also
@@ -21,7 +37,32 @@ type Client struct {
 	APIKey       string
 	AccountEmail string
 	BaseURL      *url.URL
-	client       *http.Client
+
+	// Token is a Pingdom API 3.1 bearer token. When set, requests carry
+	// an Authorization: Bearer header instead of basic auth + App-Key.
+	Token string
+
+	// APIVersion is the Pingdom API version this client targets
+	// (APIVersion21 or APIVersion31). It is recorded for callers; the
+	// services send the same request payloads under either version.
+	// Defaults to APIVersion21.
+	APIVersion string
+
+	client       HTTPClient
+	retry        RetryConfig
+	blockOnLimit bool
+
+	requestInterceptors  []RequestInterceptor
+	responseInterceptors []ResponseInterceptor
+
+	// rateMu guards rateShort/rateLong/rateSeen, which are written by
+	// every call and may be read concurrently by other calls sharing
+	// this Client.
+	rateMu    sync.Mutex
+	rateShort Rate
+	rateLong  Rate
+	rateSeen  bool
+
 	Checks       *CheckService
 	Maintenances *MaintenanceService
 	Probes       *ProbeService
@@ -38,7 +79,171 @@ type ClientConfig struct {
 	APIKey       string
 	AccountEmail string
 	BaseURL      string
-	HTTPClient   *http.Client
+
+	// Token is a Pingdom API 3.1 bearer token, sent as an Authorization:
+	// Bearer header. When empty, the client falls back to basic auth with
+	// User/Password and an App-Key header (API 2.1), so existing
+	// consumers keep working without changes; new integrations should
+	// set Token and APIVersion31, since 2.x has been sunset.
+	Token string
+
+	// APIVersion selects the default BaseURL when BaseURL is left empty:
+	// APIVersion21 (the default, for backward compatibility) or
+	// APIVersion31. Ignored if BaseURL is set explicitly.
+	APIVersion string
+
+	// HTTPClient is the transport used to send requests. Any type
+	// satisfying the HTTPClient interface works, not just *http.Client,
+	// so callers can inject instrumented transports, mocks in tests, or
+	// signed transports. Defaults to http.DefaultClient.
+	HTTPClient HTTPClient
+
+	// Retry configures automatic retries for idempotent requests that fail
+	// with a transient HTTP error (429 or 5xx). The zero value disables
+	// retries, preserving the previous behavior.
+	Retry RetryConfig
+
+	// BlockUntilRateLimitReset, when true, makes the client sleep until the
+	// short-window quota resets instead of issuing a request it already
+	// knows will be rejected with a 429, based on the last observed
+	// RateShort(). Requires a ctx with enough remaining deadline to cover
+	// the wait; DoContext returns ctx.Err() if it doesn't.
+	BlockUntilRateLimitReset bool
+
+	// RequestInterceptors run, in order, against every outgoing request
+	// (including retries) before it is sent. Use them for cross-cutting
+	// concerns like logging, tracing, metrics, or header mutation.
+	RequestInterceptors []RequestInterceptor
+
+	// ResponseInterceptors run, in order, after every attempt completes,
+	// before retry/error handling. resp is nil if the transport itself
+	// returned an error.
+	ResponseInterceptors []ResponseInterceptor
+}
+
+// HTTPClient is the minimal interface Client needs from its underlying
+// transport. *http.Client satisfies it, but callers can supply mocks,
+// instrumented transports, or anything else able to round-trip a request.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// RequestInterceptor is invoked with the outgoing request before it is
+// sent, once per attempt (including retries). Returning an error aborts
+// the call without sending the request.
+type RequestInterceptor func(req *http.Request) error
+
+// ResponseInterceptor is invoked after each attempt completes, before
+// retry/error handling runs. resp is nil if the transport returned err
+// instead of a response.
+type ResponseInterceptor func(resp *http.Response, err error)
+
+// Rate describes a Pingdom rate-limit window, as reported by the
+// Req-Limit-Short and Req-Limit-Long response headers. Pingdom's headers
+// only ever carry Remaining and the time until reset — there is no total
+// Limit field to parse, so none is exposed here.
+type Rate struct {
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+
+	// Reset is how long until the window rolls over and Remaining resets.
+	Reset time.Duration
+}
+
+// Response wraps http.Response, exposing the rate-limit budget Pingdom
+// reported for this call alongside the usual fields. Do and DoContext
+// return a *Response instead of a bare *http.Response so callers can
+// inspect their current quota without parsing headers themselves; the
+// service methods return only the decoded resource.
+type Response struct {
+	*http.Response
+
+	RateShort Rate
+	RateLong  Rate
+}
+
+// RateShort returns the most recently observed short-window (hourly)
+// rate-limit budget. It is safe for concurrent use and returns the zero
+// Rate until the first request on pc completes.
+func (pc *Client) RateShort() Rate {
+	pc.rateMu.Lock()
+	defer pc.rateMu.Unlock()
+	return pc.rateShort
+}
+
+// RateLong returns the most recently observed long-window (daily)
+// rate-limit budget. It is safe for concurrent use and returns the zero
+// Rate until the first request on pc completes.
+func (pc *Client) RateLong() Rate {
+	pc.rateMu.Lock()
+	defer pc.rateMu.Unlock()
+	return pc.rateLong
+}
+
+// setRates records the rate-limit budgets observed on the most recent
+// response.
+func (pc *Client) setRates(short, long Rate) {
+	pc.rateMu.Lock()
+	defer pc.rateMu.Unlock()
+	pc.rateShort = short
+	pc.rateLong = long
+	pc.rateSeen = true
+}
+
+// shortRateExhausted reports whether the short-window budget observed on
+// the last response had no requests remaining. It returns false until a
+// response has actually been seen, so a fresh client never blocks on a
+// Rate whose fields are merely zero-valued.
+func (pc *Client) shortRateExhausted() (Rate, bool) {
+	pc.rateMu.Lock()
+	defer pc.rateMu.Unlock()
+	return pc.rateShort, pc.rateSeen && pc.rateShort.Remaining <= 0
+}
+
+var rateFieldRe = regexp.MustCompile(`([A-Za-z][A-Za-z ]*?):\s*(\d+)`)
+
+// parseRate parses a Req-Limit-Short/Req-Limit-Long header value such as
+// "Remaining: 394 Time until reset: 3588" into a Rate.
+func parseRate(header string) Rate {
+	var r Rate
+	for _, m := range rateFieldRe.FindAllStringSubmatch(header, -1) {
+		val, err := strconv.Atoi(m[2])
+		if err != nil {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(m[1])) {
+		case "remaining":
+			r.Remaining = val
+		case "time until reset":
+			r.Reset = time.Duration(val) * time.Second
+		}
+	}
+	return r
+}
+
+// RetryConfig controls whether and how the client retries idempotent
+// requests (GET, HEAD, PUT, DELETE, OPTIONS) that fail with a transient
+// HTTP error.
+type RetryConfig struct {
+	// MaxRetries is the maximum number of retry attempts after the initial
+	// request. Zero disables retries.
+	MaxRetries int
+
+	// BaseDelay is the backoff delay used before the first retry. It is
+	// doubled on each subsequent attempt.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the computed backoff delay, regardless of attempt
+	// count. Zero means no cap.
+	MaxDelay time.Duration
+
+	// Jitter randomizes each computed delay by up to 50% to avoid many
+	// clients retrying in lockstep.
+	Jitter bool
+
+	// ShouldRetry, when set, overrides the default predicate (retry on 429
+	// or any 5xx) for deciding whether a response warrants a retry.
+	ShouldRetry func(resp *http.Response) bool
 }
 
 // NewClientWithConfig returns a Pingdom client.
@@ -47,6 +252,8 @@ func NewClientWithConfig(config ClientConfig) (*Client, error) {
 	var err error
 	if config.BaseURL != "" {
 		baseURL, err = url.Parse(config.BaseURL)
+	} else if config.APIVersion == APIVersion31 {
+		baseURL, err = url.Parse(defaultBaseURLV3)
 	} else {
 		baseURL, err = url.Parse(defaultBaseURL)
 	}
@@ -54,12 +261,24 @@ func NewClientWithConfig(config ClientConfig) (*Client, error) {
 		return nil, err
 	}
 
+	apiVersion := config.APIVersion
+	if apiVersion == "" {
+		apiVersion = APIVersion21
+	}
+
 	c := &Client{
 		User:         config.User,
 		Password:     config.Password,
 		APIKey:       config.APIKey,
 		AccountEmail: config.AccountEmail,
 		BaseURL:      baseURL,
+		Token:        config.Token,
+		APIVersion:   apiVersion,
+		retry:        config.Retry,
+		blockOnLimit: config.BlockUntilRateLimitReset,
+
+		requestInterceptors:  config.RequestInterceptors,
+		responseInterceptors: config.ResponseInterceptors,
 	}
 
 	if config.HTTPClient != nil {
@@ -110,6 +329,14 @@ func NewMultiUserClient(user string, password string, key string, accountEmail s
 // ListChecks, etc but this method is provided to allow for making other
 // API calls that might not be built in.
 func (pc *Client) NewRequest(method string, rsc string, params map[string]string) (*http.Request, error) {
+	return pc.NewRequestWithContext(context.Background(), method, rsc, params)
+}
+
+// NewRequestWithContext is the context-aware equivalent of NewRequest. The
+// context is attached to the returned request so that Do/DoContext can
+// honor cancellation and deadlines for the lifetime of the call, including
+// any retries.
+func (pc *Client) NewRequestWithContext(ctx context.Context, method string, rsc string, params map[string]string) (*http.Request, error) {
 	baseURL, err := url.Parse(pc.BaseURL.String() + rsc)
 	if err != nil {
 		return nil, err
@@ -123,60 +350,166 @@ func (pc *Client) NewRequest(method string, rsc string, params map[string]string
 		baseURL.RawQuery = ps.Encode()
 	}
 
-	req, err := http.NewRequest(method, baseURL.String(), nil)
-	req.SetBasicAuth(pc.User, pc.Password)
-	req.Header.Add("App-Key", pc.APIKey)
+	req, err := http.NewRequestWithContext(ctx, method, baseURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if pc.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+pc.Token)
+	} else {
+		req.SetBasicAuth(pc.User, pc.Password)
+		req.Header.Add("App-Key", pc.APIKey)
+	}
 	if pc.AccountEmail != "" {
 		req.Header.Add("Account-Email", pc.AccountEmail)
 	}
-	return req, err
+	return req, nil
 }
 
 // Do makes an HTTP request and will unmarshal the JSON response in to the
 // passed in interface.  If the HTTP response is outside of the 2xx range the
 // response will be returned along with the error.
-func (pc *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
-	resp, err := pc.client.Do(req)
-	if err != nil {
-		return nil, err
+func (pc *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+	return pc.DoContext(req.Context(), req, v)
+}
+
+// DoContext is the context-aware equivalent of Do. If the client was
+// configured with a RetryConfig, idempotent requests (GET, HEAD, PUT,
+// DELETE, OPTIONS) that fail with a 429 or 5xx response are retried with
+// exponential backoff, honoring a Retry-After header when the API sends
+// one. ctx governs the whole call, including any waits between retries.
+//
+// If the client was configured with BlockUntilRateLimitReset and the last
+// observed short-window budget is exhausted, DoContext sleeps until it
+// resets before issuing the request, rather than sending one that Pingdom
+// will reject with a 429.
+func (pc *Client) DoContext(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if pc.blockOnLimit {
+		if rate, exhausted := pc.shortRateExhausted(); exhausted {
+			if err := sleepContext(ctx, rate.Reset); err != nil {
+				return nil, err
+			}
+		}
 	}
-	defer resp.Body.Close()
 
-	if err := validateResponse(resp); err != nil {
+	for attempt := 0; ; attempt++ {
+		attemptReq := req.WithContext(ctx)
+		for _, intercept := range pc.requestInterceptors {
+			if err := intercept(attemptReq); err != nil {
+				return nil, err
+			}
+		}
+
+		httpResp, err := pc.client.Do(attemptReq)
+		for _, intercept := range pc.responseInterceptors {
+			intercept(httpResp, err)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		resp := &Response{
+			Response:  httpResp,
+			RateShort: parseRate(httpResp.Header.Get("Req-Limit-Short")),
+			RateLong:  parseRate(httpResp.Header.Get("Req-Limit-Long")),
+		}
+		pc.setRates(resp.RateShort, resp.RateLong)
+
+		if verr := validateResponse(httpResp); verr != nil {
+			if attempt < pc.retry.MaxRetries && isIdempotent(req.Method) && pc.shouldRetry(httpResp) {
+				delay := pc.retry.nextDelay(attempt, httpResp)
+				drainAndClose(httpResp.Body)
+				if werr := sleepContext(ctx, delay); werr != nil {
+					return resp, werr
+				}
+				continue
+			}
+			drainAndClose(httpResp.Body)
+			return resp, verr
+		}
+
+		err = decodeResponse(httpResp, v)
+		drainAndClose(httpResp.Body)
 		return resp, err
 	}
+}
 
-	err = decodeResponse(resp, v)
-	return resp, err
+// drainAndClose discards any unread bytes before closing body, so the
+// underlying connection can be reused for the next request instead of
+// being forced closed by net/http.
+func drainAndClose(body io.ReadCloser) {
+	io.Copy(io.Discard, body)
+	body.Close()
+}
 
+// shouldRetry reports whether resp warrants a retry under pc's RetryConfig.
+func (pc *Client) shouldRetry(resp *http.Response) bool {
+	if pc.retry.ShouldRetry != nil {
+		return pc.retry.ShouldRetry(resp)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
 }
 
-func decodeResponse(r *http.Response, v interface{}) error {
-	if v == nil {
-		return fmt.Errorf("nil interface provided to decodeResponse")
+// isIdempotent reports whether method is safe to automatically retry.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// nextDelay computes the backoff delay before the given retry attempt
+// (0-indexed), preferring the API's Retry-After header when present.
+func (rc RetryConfig) nextDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d := retryAfter(resp); d > 0 {
+			return d
+		}
+	}
+
+	delay := rc.BaseDelay << uint(attempt)
+	if rc.MaxDelay > 0 && delay > rc.MaxDelay {
+		delay = rc.MaxDelay
+	}
+	if rc.Jitter {
+		delay = jitter(delay)
 	}
+	return delay
+}
 
-	bodyBytes, _ := ioutil.ReadAll(r.Body)
-	bodyString := string(bodyBytes)
-	err := json.Unmarshal([]byte(bodyString), &v)
-	return err
+// jitter randomizes d by up to 50% in either direction.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d)))
 }
 
-// Takes an HTTP response and determines whether it was successful.
-// Returns nil if the HTTP status code is within the 2xx range.  Returns
-// an error otherwise.
-func validateResponse(r *http.Response) error {
-	if c := r.StatusCode; 200 <= c && c <= 299 {
+// sleepContext waits for d or returns early with ctx's error if ctx is
+// done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
 		return nil
 	}
+}
 
-	bodyBytes, _ := ioutil.ReadAll(r.Body)
-	bodyString := string(bodyBytes)
-	m := &errorJSONResponse{}
-	err := json.Unmarshal([]byte(bodyString), &m)
-	if err != nil {
-		return err
+// decodeResponse streams r's body straight into v, without buffering the
+// whole response in memory first.
+func decodeResponse(r *http.Response, v interface{}) error {
+	if v == nil {
+		return fmt.Errorf("nil interface provided to decodeResponse")
 	}
 
-	return m.Error
+	return json.NewDecoder(r.Body).Decode(v)
 }
@@ -0,0 +1,85 @@
+package pingdom
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIsIdempotent(t *testing.T) {
+	cases := map[string]bool{
+		http.MethodGet:     true,
+		http.MethodHead:    true,
+		http.MethodPut:     true,
+		http.MethodDelete:  true,
+		http.MethodOptions: true,
+		http.MethodPost:    false,
+		http.MethodPatch:   false,
+	}
+	for method, want := range cases {
+		if got := isIdempotent(method); got != want {
+			t.Errorf("isIdempotent(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
+
+func TestRetryConfigNextDelay(t *testing.T) {
+	rc := RetryConfig{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	if d := rc.nextDelay(0, nil); d != 100*time.Millisecond {
+		t.Errorf("attempt 0: got %v, want 100ms", d)
+	}
+	if d := rc.nextDelay(1, nil); d != 200*time.Millisecond {
+		t.Errorf("attempt 1: got %v, want 200ms", d)
+	}
+	if d := rc.nextDelay(10, nil); d != time.Second {
+		t.Errorf("attempt 10: got %v, want capped at 1s", d)
+	}
+}
+
+func TestRetryConfigNextDelayHonorsRetryAfter(t *testing.T) {
+	rc := RetryConfig{BaseDelay: 100 * time.Millisecond}
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"5"}}}
+
+	if d := rc.nextDelay(0, resp); d != 5*time.Second {
+		t.Errorf("got %v, want 5s from Retry-After", d)
+	}
+}
+
+func TestJitterStaysWithinBounds(t *testing.T) {
+	d := 100 * time.Millisecond
+	for i := 0; i < 100; i++ {
+		j := jitter(d)
+		if j < d/2 || j > 3*d/2 {
+			t.Fatalf("jitter(%v) = %v, want within [%v, %v]", d, j, d/2, 3*d/2)
+		}
+	}
+}
+
+func TestSleepContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := sleepContext(ctx, time.Second); err == nil {
+		t.Fatal("expected error from canceled context, got nil")
+	}
+}
+
+func TestNewRequestWithContextPropagatesContext(t *testing.T) {
+	c, err := NewClientWithConfig(ClientConfig{APIKey: "key"})
+	if err != nil {
+		t.Fatalf("NewClientWithConfig: %v", err)
+	}
+
+	type ctxKey string
+	ctx := context.WithValue(context.Background(), ctxKey("k"), "v")
+
+	req, err := c.NewRequestWithContext(ctx, "GET", "/checks", nil)
+	if err != nil {
+		t.Fatalf("NewRequestWithContext: %v", err)
+	}
+	if req.Context().Value(ctxKey("k")) != "v" {
+		t.Fatal("request does not carry the context it was built with")
+	}
+}
@@ -0,0 +1,43 @@
+package pingdom
+
+import "context"
+
+// Probe represents a Pingdom probe server.
+type Probe struct {
+	ID         int    `json:"id"`
+	Country    string `json:"country"`
+	City       string `json:"city"`
+	Name       string `json:"name"`
+	Active     bool   `json:"active"`
+	Hostname   string `json:"hostname"`
+	IP         string `json:"ip"`
+	CountryISO string `json:"countryiso"`
+}
+
+type probeListResponse struct {
+	Probes []Probe `json:"probes"`
+}
+
+// ProbeService provides access to the read-only /probes resource.
+type ProbeService struct {
+	client *Client
+}
+
+// List returns all probe servers.
+func (ps *ProbeService) List(params ...map[string]string) ([]Probe, error) {
+	return ps.ListContext(context.Background(), params...)
+}
+
+// ListContext is the context-aware equivalent of List.
+func (ps *ProbeService) ListContext(ctx context.Context, params ...map[string]string) ([]Probe, error) {
+	req, err := ps.client.NewRequestWithContext(ctx, "GET", "/probes", mergeParams(params))
+	if err != nil {
+		return nil, err
+	}
+
+	m := &probeListResponse{}
+	if _, err := ps.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m.Probes, nil
+}
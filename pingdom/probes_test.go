@@ -0,0 +1,27 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestProbeServiceListParsesEnvelope(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/probes"; got != want {
+			t.Errorf("path = %q, want %q", got, want)
+		}
+		json.NewEncoder(w).Encode(probeListResponse{
+			Probes: []Probe{{ID: 1, Country: "US"}},
+		})
+	})
+	defer srv.Close()
+
+	probes, err := c.Probes.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(probes) != 1 || probes[0].Country != "US" {
+		t.Errorf("List() = %+v, want a single US probe", probes)
+	}
+}
@@ -0,0 +1,38 @@
+package pingdom
+
+import "context"
+
+// PublicCheck represents a check exposed via Pingdom's public reports.
+type PublicCheck struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type publicCheckListResponse struct {
+	PublicChecks []PublicCheck `json:"public"`
+}
+
+// PublicReportService provides access to the read-only
+// /reports.public resource.
+type PublicReportService struct {
+	client *Client
+}
+
+// List returns all checks with public reports enabled.
+func (ps *PublicReportService) List() ([]PublicCheck, error) {
+	return ps.ListContext(context.Background())
+}
+
+// ListContext is the context-aware equivalent of List.
+func (ps *PublicReportService) ListContext(ctx context.Context) ([]PublicCheck, error) {
+	req, err := ps.client.NewRequestWithContext(ctx, "GET", "/reports.public", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &publicCheckListResponse{}
+	if _, err := ps.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m.PublicChecks, nil
+}
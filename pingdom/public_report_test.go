@@ -0,0 +1,27 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestPublicReportServiceListParsesEnvelope(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/reports.public"; got != want {
+			t.Errorf("path = %q, want %q", got, want)
+		}
+		json.NewEncoder(w).Encode(publicCheckListResponse{
+			PublicChecks: []PublicCheck{{ID: 1, Name: "site"}},
+		})
+	})
+	defer srv.Close()
+
+	checks, err := c.PublicReport.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(checks) != 1 || checks[0].Name != "site" {
+		t.Errorf("List() = %+v, want a single public check named site", checks)
+	}
+}
@@ -0,0 +1,43 @@
+package pingdom
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRate(t *testing.T) {
+	r := parseRate("Remaining: 394 Time until reset: 3588")
+	if r.Remaining != 394 {
+		t.Errorf("Remaining = %d, want 394", r.Remaining)
+	}
+	if r.Reset != 3588*time.Second {
+		t.Errorf("Reset = %v, want 3588s", r.Reset)
+	}
+}
+
+func TestParseRateEmptyHeader(t *testing.T) {
+	r := parseRate("")
+	if r != (Rate{}) {
+		t.Errorf("parseRate(\"\") = %+v, want the zero value", r)
+	}
+}
+
+func TestClientShortRateExhaustedRequiresObservedResponse(t *testing.T) {
+	c, err := NewClientWithConfig(ClientConfig{APIKey: "key"})
+	if err != nil {
+		t.Fatalf("NewClientWithConfig: %v", err)
+	}
+
+	if _, exhausted := c.shortRateExhausted(); exhausted {
+		t.Fatal("a fresh client that has never seen a response must never report its budget exhausted")
+	}
+
+	c.setRates(Rate{Remaining: 0, Reset: time.Minute}, Rate{})
+	if _, exhausted := c.shortRateExhausted(); !exhausted {
+		t.Fatal("expected exhausted once a response reports Remaining <= 0")
+	}
+
+	if got := c.RateShort(); got.Remaining != 0 {
+		t.Errorf("RateShort().Remaining = %d, want 0", got.Remaining)
+	}
+}
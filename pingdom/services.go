@@ -0,0 +1,25 @@
+package pingdom
+
+// PingdomResponse represents the short acknowledgement returned by
+// mutating endpoints (create/update/delete) that don't echo back the
+// full resource.
+type PingdomResponse struct {
+	Message string `json:"message"`
+}
+
+// mergeParams flattens the optional params vararg used by List methods
+// (so callers can write List() or List(map[string]string{...})) into a
+// single map. Later maps win on key conflicts.
+func mergeParams(params []map[string]string) map[string]string {
+	if len(params) == 0 {
+		return nil
+	}
+
+	merged := map[string]string{}
+	for _, p := range params {
+		for k, v := range p {
+			merged[k] = v
+		}
+	}
+	return merged
+}
@@ -0,0 +1,139 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Team represents the payload used to create or update a Pingdom team.
+type Team struct {
+	Name    string
+	UserIDs []int
+}
+
+// params converts t into the form-encoded params the team endpoint
+// expects. userids is a comma-separated string, the same convention
+// Maintenance.CheckIDs uses.
+func (t *Team) params() map[string]string {
+	ids := make([]string, len(t.UserIDs))
+	for i, id := range t.UserIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+	return map[string]string{
+		"name":    t.Name,
+		"userids": strings.Join(ids, ","),
+	}
+}
+
+// TeamResponse represents a Pingdom team as returned by the API.
+type TeamResponse struct {
+	ID    int            `json:"id"`
+	Name  string         `json:"name"`
+	Users []UserResponse `json:"users,omitempty"`
+}
+
+type teamListResponse struct {
+	Teams []TeamResponse `json:"teams"`
+}
+
+// TeamService provides access to the /team resource.
+type TeamService struct {
+	client *Client
+}
+
+// List returns all teams on the account.
+func (ts *TeamService) List() ([]TeamResponse, error) {
+	return ts.ListContext(context.Background())
+}
+
+// ListContext is the context-aware equivalent of List.
+func (ts *TeamService) ListContext(ctx context.Context) ([]TeamResponse, error) {
+	req, err := ts.client.NewRequestWithContext(ctx, "GET", "/team", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &teamListResponse{}
+	if _, err := ts.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m.Teams, nil
+}
+
+// Read returns a single team by id.
+func (ts *TeamService) Read(id int) (*TeamResponse, error) {
+	return ts.ReadContext(context.Background(), id)
+}
+
+// ReadContext is the context-aware equivalent of Read.
+func (ts *TeamService) ReadContext(ctx context.Context, id int) (*TeamResponse, error) {
+	req, err := ts.client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/team/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &TeamResponse{}
+	if _, err := ts.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Create creates a new team.
+func (ts *TeamService) Create(team *Team) (*TeamResponse, error) {
+	return ts.CreateContext(context.Background(), team)
+}
+
+// CreateContext is the context-aware equivalent of Create.
+func (ts *TeamService) CreateContext(ctx context.Context, team *Team) (*TeamResponse, error) {
+	req, err := ts.client.NewRequestWithContext(ctx, "POST", "/team", team.params())
+	if err != nil {
+		return nil, err
+	}
+
+	m := &TeamResponse{}
+	if _, err := ts.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Update updates an existing team.
+func (ts *TeamService) Update(id int, team *Team) (*PingdomResponse, error) {
+	return ts.UpdateContext(context.Background(), id, team)
+}
+
+// UpdateContext is the context-aware equivalent of Update.
+func (ts *TeamService) UpdateContext(ctx context.Context, id int, team *Team) (*PingdomResponse, error) {
+	req, err := ts.client.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("/team/%d", id), team.params())
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	if _, err := ts.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Delete removes a team.
+func (ts *TeamService) Delete(id int) (*PingdomResponse, error) {
+	return ts.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is the context-aware equivalent of Delete.
+func (ts *TeamService) DeleteContext(ctx context.Context, id int) (*PingdomResponse, error) {
+	req, err := ts.client.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/team/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	if _, err := ts.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,62 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestTeamServiceCreateSendsUserIDsAndReturnsTeam(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, "POST"; got != want {
+			t.Errorf("method = %q, want %q", got, want)
+		}
+		if got, want := r.URL.Query().Get("userids"), "1,2"; got != want {
+			t.Errorf("userids param = %q, want %q", got, want)
+		}
+		json.NewEncoder(w).Encode(TeamResponse{ID: 5, Name: "oncall"})
+	})
+	defer srv.Close()
+
+	team, err := c.Teams.Create(&Team{Name: "oncall", UserIDs: []int{1, 2}})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if team.ID != 5 || team.Name != "oncall" {
+		t.Errorf("Create() = %+v, want id 5 named oncall", team)
+	}
+}
+
+func TestTeamServiceUpdateBuildsExpectedPath(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, "PUT"; got != want {
+			t.Errorf("method = %q, want %q", got, want)
+		}
+		if got, want := r.URL.Path, "/team/5"; got != want {
+			t.Errorf("path = %q, want %q", got, want)
+		}
+		json.NewEncoder(w).Encode(PingdomResponse{Message: "ok"})
+	})
+	defer srv.Close()
+
+	if _, err := c.Teams.Update(5, &Team{Name: "renamed"}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+}
+
+func TestTeamServiceListParsesEnvelope(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(teamListResponse{
+			Teams: []TeamResponse{{ID: 1, Users: []UserResponse{{ID: 10, Username: "alice"}}}},
+		})
+	})
+	defer srv.Close()
+
+	teams, err := c.Teams.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(teams) != 1 || len(teams[0].Users) != 1 || teams[0].Users[0].Username != "alice" {
+		t.Errorf("List() = %+v, want a single team with member alice", teams)
+	}
+}
@@ -0,0 +1,168 @@
+package pingdom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// TmsStep is a single step of a transaction check. API 2.1 described a
+// step as a flat Fn/Arg pair; 3.1 replaced that with a Type discriminator
+// plus a typed Args object. Both are kept here, tagged omitempty, so a
+// TmsStep built for one version round-trips cleanly and callers migrating
+// to 3.1 can set Type/Args instead of Fn/Arg.
+type TmsStep struct {
+	Fn  string `json:"fn,omitempty"`
+	Arg string `json:"arg,omitempty"`
+
+	Type string            `json:"type,omitempty"`
+	Args map[string]string `json:"args,omitempty"`
+}
+
+// Tms represents the payload used to create or update a transaction
+// check.
+type Tms struct {
+	Name  string
+	Steps []TmsStep
+}
+
+// params converts t into the form-encoded params the tms/check endpoint
+// expects. Steps is a structured list, so unlike the other services'
+// params it is sent JSON-encoded in a single "steps" field rather than
+// flattened into a comma-separated one.
+func (t *Tms) params() (map[string]string, error) {
+	p := map[string]string{"name": t.Name}
+	if len(t.Steps) > 0 {
+		b, err := json.Marshal(t.Steps)
+		if err != nil {
+			return nil, err
+		}
+		p["steps"] = string(b)
+	}
+	return p, nil
+}
+
+// TmsResponse represents a transaction check as returned by the API.
+type TmsResponse struct {
+	ID    int       `json:"id"`
+	Name  string    `json:"name"`
+	Steps []TmsStep `json:"steps,omitempty"`
+}
+
+type tmsListResponse struct {
+	Checks []TmsResponse `json:"checks"`
+}
+
+type tmsDetailsResponse struct {
+	Check TmsResponse `json:"check"`
+}
+
+// TmsService provides access to the /tms/check resource.
+type TmsService struct {
+	client *Client
+}
+
+// List returns all transaction checks.
+func (ts *TmsService) List(params ...map[string]string) ([]TmsResponse, error) {
+	return ts.ListContext(context.Background(), params...)
+}
+
+// ListContext is the context-aware equivalent of List.
+func (ts *TmsService) ListContext(ctx context.Context, params ...map[string]string) ([]TmsResponse, error) {
+	req, err := ts.client.NewRequestWithContext(ctx, "GET", "/tms/check", mergeParams(params))
+	if err != nil {
+		return nil, err
+	}
+
+	m := &tmsListResponse{}
+	if _, err := ts.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m.Checks, nil
+}
+
+// Read returns a single transaction check by id.
+func (ts *TmsService) Read(id int) (*TmsResponse, error) {
+	return ts.ReadContext(context.Background(), id)
+}
+
+// ReadContext is the context-aware equivalent of Read.
+func (ts *TmsService) ReadContext(ctx context.Context, id int) (*TmsResponse, error) {
+	req, err := ts.client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/tms/check/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &tmsDetailsResponse{}
+	if _, err := ts.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return &m.Check, nil
+}
+
+// Create creates a new transaction check.
+func (ts *TmsService) Create(tms *Tms) (*TmsResponse, error) {
+	return ts.CreateContext(context.Background(), tms)
+}
+
+// CreateContext is the context-aware equivalent of Create.
+func (ts *TmsService) CreateContext(ctx context.Context, tms *Tms) (*TmsResponse, error) {
+	params, err := tms.params()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := ts.client.NewRequestWithContext(ctx, "POST", "/tms/check", params)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &tmsDetailsResponse{}
+	if _, err := ts.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return &m.Check, nil
+}
+
+// Update updates an existing transaction check.
+func (ts *TmsService) Update(id int, tms *Tms) (*PingdomResponse, error) {
+	return ts.UpdateContext(context.Background(), id, tms)
+}
+
+// UpdateContext is the context-aware equivalent of Update.
+func (ts *TmsService) UpdateContext(ctx context.Context, id int, tms *Tms) (*PingdomResponse, error) {
+	params, err := tms.params()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := ts.client.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("/tms/check/%d", id), params)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	if _, err := ts.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Delete removes a transaction check.
+func (ts *TmsService) Delete(id int) (*PingdomResponse, error) {
+	return ts.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is the context-aware equivalent of Delete.
+func (ts *TmsService) DeleteContext(ctx context.Context, id int) (*PingdomResponse, error) {
+	req, err := ts.client.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/tms/check/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	if _, err := ts.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,56 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestTmsServiceCreateSendsStepsAsJSONAndReturnsCheck(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var steps []TmsStep
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("steps")), &steps); err != nil {
+			t.Fatalf("steps param did not decode as JSON: %v", err)
+		}
+		if len(steps) != 1 || steps[0].Type != "goto" || steps[0].Args["url"] != "https://example.com" {
+			t.Errorf("decoded steps = %+v, want a single goto step", steps)
+		}
+		json.NewEncoder(w).Encode(tmsDetailsResponse{
+			Check: TmsResponse{ID: 11, Name: "login flow"},
+		})
+	})
+	defer srv.Close()
+
+	tms, err := c.Tms.Create(&Tms{
+		Name: "login flow",
+		Steps: []TmsStep{
+			{Type: "goto", Args: map[string]string{"url": "https://example.com"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if tms.ID != 11 || tms.Name != "login flow" {
+		t.Errorf("Create() = %+v, want id 11 named login flow", tms)
+	}
+}
+
+func TestTmsServiceReadParsesEnvelope(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/tms/check/11"; got != want {
+			t.Errorf("path = %q, want %q", got, want)
+		}
+		json.NewEncoder(w).Encode(tmsDetailsResponse{
+			Check: TmsResponse{ID: 11, Steps: []TmsStep{{Fn: "get", Arg: "https://example.com"}}},
+		})
+	})
+	defer srv.Close()
+
+	tms, err := c.Tms.Read(11)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if len(tms.Steps) != 1 || tms.Steps[0].Fn != "get" {
+		t.Errorf("Read() = %+v, want a single get step", tms)
+	}
+}
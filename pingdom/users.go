@@ -0,0 +1,157 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Contact is a single contact target (email or SMS) belonging to a user.
+type Contact struct {
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+// User represents the payload used to create or update a Pingdom user.
+type User struct {
+	Username string
+	Contacts []Contact
+}
+
+// params converts u into the form-encoded params the users endpoint
+// expects. Email and phone contact targets are each flattened into their
+// own comma-separated field.
+func (u *User) params() map[string]string {
+	p := map[string]string{"username": u.Username}
+
+	var emails, phones []string
+	for _, c := range u.Contacts {
+		if c.Email != "" {
+			emails = append(emails, c.Email)
+		}
+		if c.Phone != "" {
+			phones = append(phones, c.Phone)
+		}
+	}
+	if len(emails) > 0 {
+		p["email"] = strings.Join(emails, ",")
+	}
+	if len(phones) > 0 {
+		p["sms"] = strings.Join(phones, ",")
+	}
+	return p
+}
+
+// UserResponse represents a Pingdom user as returned by the API.
+type UserResponse struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+type userListResponse struct {
+	Users []UserResponse `json:"users"`
+}
+
+type userDetailsResponse struct {
+	User UserResponse `json:"user"`
+}
+
+// UserService provides access to the /users resource.
+type UserService struct {
+	client *Client
+}
+
+// List returns all users on the account.
+func (us *UserService) List() ([]UserResponse, error) {
+	return us.ListContext(context.Background())
+}
+
+// ListContext is the context-aware equivalent of List.
+func (us *UserService) ListContext(ctx context.Context) ([]UserResponse, error) {
+	req, err := us.client.NewRequestWithContext(ctx, "GET", "/users", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &userListResponse{}
+	if _, err := us.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m.Users, nil
+}
+
+// Read returns a single user by id.
+func (us *UserService) Read(id int) (*UserResponse, error) {
+	return us.ReadContext(context.Background(), id)
+}
+
+// ReadContext is the context-aware equivalent of Read.
+func (us *UserService) ReadContext(ctx context.Context, id int) (*UserResponse, error) {
+	req, err := us.client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("/users/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &userDetailsResponse{}
+	if _, err := us.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return &m.User, nil
+}
+
+// Create creates a new user.
+func (us *UserService) Create(user *User) (*UserResponse, error) {
+	return us.CreateContext(context.Background(), user)
+}
+
+// CreateContext is the context-aware equivalent of Create.
+func (us *UserService) CreateContext(ctx context.Context, user *User) (*UserResponse, error) {
+	req, err := us.client.NewRequestWithContext(ctx, "POST", "/users", user.params())
+	if err != nil {
+		return nil, err
+	}
+
+	m := &userDetailsResponse{}
+	if _, err := us.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return &m.User, nil
+}
+
+// Update updates an existing user.
+func (us *UserService) Update(id int, user *User) (*PingdomResponse, error) {
+	return us.UpdateContext(context.Background(), id, user)
+}
+
+// UpdateContext is the context-aware equivalent of Update.
+func (us *UserService) UpdateContext(ctx context.Context, id int, user *User) (*PingdomResponse, error) {
+	req, err := us.client.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("/users/%d", id), user.params())
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	if _, err := us.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Delete removes a user.
+func (us *UserService) Delete(id int) (*PingdomResponse, error) {
+	return us.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is the context-aware equivalent of Delete.
+func (us *UserService) DeleteContext(ctx context.Context, id int) (*PingdomResponse, error) {
+	req, err := us.client.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("/users/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	if _, err := us.client.DoContext(ctx, req, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,55 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestUserServiceCreateFlattensContactsAndReturnsUser(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if got, want := q.Get("email"), "a@example.com,b@example.com"; got != want {
+			t.Errorf("email param = %q, want %q", got, want)
+		}
+		if got, want := q.Get("sms"), "+15555550100"; got != want {
+			t.Errorf("sms param = %q, want %q", got, want)
+		}
+		json.NewEncoder(w).Encode(userDetailsResponse{
+			User: UserResponse{ID: 3, Username: "alice"},
+		})
+	})
+	defer srv.Close()
+
+	user, err := c.Users.Create(&User{
+		Username: "alice",
+		Contacts: []Contact{
+			{Email: "a@example.com"},
+			{Email: "b@example.com"},
+			{Phone: "+15555550100"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if user.ID != 3 || user.Username != "alice" {
+		t.Errorf("Create() = %+v, want id 3 named alice", user)
+	}
+}
+
+func TestUserServiceDeleteBuildsExpectedPath(t *testing.T) {
+	c, srv := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, "DELETE"; got != want {
+			t.Errorf("method = %q, want %q", got, want)
+		}
+		if got, want := r.URL.Path, "/users/3"; got != want {
+			t.Errorf("path = %q, want %q", got, want)
+		}
+		json.NewEncoder(w).Encode(PingdomResponse{Message: "ok"})
+	})
+	defer srv.Close()
+
+	if _, err := c.Users.Delete(3); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+}